@@ -0,0 +1,44 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchClusterRef names the OpenSearchCluster (in the same namespace) a namespaced resource
+// applies to.
+type OpenSearchClusterRef struct {
+	Name string `json:"name"`
+}
+
+// OpenSearchIndexTemplateSpec declares an index template to keep applied to the referenced cluster.
+type OpenSearchIndexTemplateSpec struct {
+	// OpensearchRef names the target OpenSearchCluster.
+	OpensearchRef OpenSearchClusterRef `json:"opensearchRef"`
+	// Name is the index template name as used by the OpenSearch _index_template API.
+	Name string `json:"name"`
+	// Body is the raw index template document.
+	Body string `json:"body"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchIndexTemplate is the Schema for the opensearchindextemplates API.
+type OpenSearchIndexTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OpenSearchIndexTemplateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchIndexTemplateList contains a list of OpenSearchIndexTemplate.
+type OpenSearchIndexTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchIndexTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenSearchIndexTemplate{}, &OpenSearchIndexTemplateList{})
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenSearchISMPolicySpec declares an Index State Management policy to keep applied to the
+// referenced cluster.
+type OpenSearchISMPolicySpec struct {
+	// OpensearchRef names the target OpenSearchCluster.
+	OpensearchRef OpenSearchClusterRef `json:"opensearchRef"`
+	// PolicyID is the policy id as used by the OpenSearch ISM _plugins/_ism/policies API.
+	PolicyID string `json:"policyID"`
+	// Body is the raw ISM policy document.
+	Body string `json:"body"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchISMPolicy is the Schema for the opensearchismpolicies API.
+type OpenSearchISMPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OpenSearchISMPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenSearchISMPolicyList contains a list of OpenSearchISMPolicy.
+type OpenSearchISMPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenSearchISMPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenSearchISMPolicy{}, &OpenSearchISMPolicyList{})
+}
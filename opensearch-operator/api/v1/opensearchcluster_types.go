@@ -36,8 +36,23 @@ type NodePool struct {
 
 // DashboardsConfig configures the optional OpenSearch Dashboards deployment.
 type DashboardsConfig struct {
-	Enable   bool  `json:"enable,omitempty"`
-	Replicas int32 `json:"replicas,omitempty"`
+	Enable   bool               `json:"enable,omitempty"`
+	Replicas int32              `json:"replicas,omitempty"`
+	Ingress  *DashboardsIngress `json:"ingress,omitempty"`
+}
+
+// DashboardsIngress configures an Ingress exposing Dashboards outside the cluster.
+type DashboardsIngress struct {
+	Host             string                `json:"host,omitempty"`
+	IngressClassName *string               `json:"ingressClassName,omitempty"`
+	Annotations      map[string]string     `json:"annotations,omitempty"`
+	Tls              *DashboardsIngressTLS `json:"tls,omitempty"`
+}
+
+// DashboardsIngressTLS configures the TLS section of the Dashboards Ingress. SecretName may
+// reference a user-supplied Secret; when empty the operator provisions one itself.
+type DashboardsIngressTLS struct {
+	SecretName string `json:"secretName,omitempty"`
 }
 
 // ClusterStatus reflects the observed state of an OpenSearchCluster.
@@ -1,16 +1,29 @@
 package v1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // TlsConfig configures certificates for the transport and http interfaces.
 type TlsConfig struct {
 	Transport *TlsInterfaceConfig `json:"transport,omitempty"`
 	Http      *TlsInterfaceConfig `json:"http,omitempty"`
 	NodesDn   []string            `json:"nodesDn,omitempty"`
+	// RenewBefore sets how long before expiry a self-signed (Generate) certificate is rotated.
+	// Defaults to 30 days. Has no effect on CertManager-sourced or user-supplied certificates.
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
 }
 
 // TlsInterfaceConfig configures how one interface's (transport or http) certificate is sourced.
 type TlsInterfaceConfig struct {
 	// Generate, when true, has the operator create and self-sign the certificate.
-	Generate   bool       `json:"generate,omitempty"`
+	Generate bool `json:"generate,omitempty"`
+	// CertManager sources the certificate from a cert-manager Issuer/ClusterIssuer instead of
+	// self-signing. Mutually exclusive with Generate.
+	CertManager *CertManagerConfig `json:"certManager,omitempty"`
+	// PerNode, when combined with Generate, issues each node its own leaf certificate instead of
+	// sharing one cluster-wide certificate. Not supported together with CertManager.
+	PerNode    bool       `json:"perNode,omitempty"`
 	CaSecret   *TlsSecret `json:"caSecret,omitempty"`
 	CertSecret *TlsSecret `json:"certSecret,omitempty"`
 	KeySecret  *TlsSecret `json:"keySecret,omitempty"`
@@ -21,3 +34,23 @@ type TlsSecret struct {
 	SecretName string  `json:"secretName,omitempty"`
 	Key        *string `json:"key,omitempty"`
 }
+
+// CertManagerConfig requests a cert-manager Certificate instead of a self-signed one.
+type CertManagerConfig struct {
+	// IssuerRef names the Issuer or ClusterIssuer cert-manager should use.
+	IssuerRef CertManagerIssuerRef `json:"issuerRef"`
+	// CA requests a separate CA Certificate be issued through cert-manager as well, instead of
+	// reusing an existing one. Leave nil to only issue the leaf certificate.
+	CA *CertManagerCAConfig `json:"ca,omitempty"`
+}
+
+// CertManagerIssuerRef identifies the cert-manager Issuer or ClusterIssuer to request from.
+type CertManagerIssuerRef struct {
+	Name string `json:"name"`
+	// Kind is "Issuer" or "ClusterIssuer". Defaults to "Issuer".
+	Kind string `json:"kind,omitempty"`
+}
+
+// CertManagerCAConfig has no fields yet; its presence alone signals that a CA Certificate should
+// be requested from cert-manager.
+type CertManagerCAConfig struct{}
@@ -5,6 +5,7 @@
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -23,7 +24,7 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.Dashboards = in.Dashboards
+	in.Dashboards.DeepCopyInto(&out.Dashboards)
 }
 
 // DeepCopy is a deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -82,6 +83,11 @@ func (in *NodePool) DeepCopy() *NodePool {
 
 func (in *DashboardsConfig) DeepCopyInto(out *DashboardsConfig) {
 	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(DashboardsIngress)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 func (in *DashboardsConfig) DeepCopy() *DashboardsConfig {
@@ -93,6 +99,49 @@ func (in *DashboardsConfig) DeepCopy() *DashboardsConfig {
 	return out
 }
 
+func (in *DashboardsIngress) DeepCopyInto(out *DashboardsIngress) {
+	*out = *in
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tls != nil {
+		in, out := &in.Tls, &out.Tls
+		*out = new(DashboardsIngressTLS)
+		**out = **in
+	}
+}
+
+func (in *DashboardsIngress) DeepCopy() *DashboardsIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardsIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *DashboardsIngressTLS) DeepCopyInto(out *DashboardsIngressTLS) {
+	*out = *in
+}
+
+func (in *DashboardsIngressTLS) DeepCopy() *DashboardsIngressTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardsIngressTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
 	if in.ComponentsStatus != nil {
@@ -197,6 +246,11 @@ func (in *TlsConfig) DeepCopyInto(out *TlsConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 func (in *TlsConfig) DeepCopy() *TlsConfig {
@@ -210,6 +264,11 @@ func (in *TlsConfig) DeepCopy() *TlsConfig {
 
 func (in *TlsInterfaceConfig) DeepCopyInto(out *TlsInterfaceConfig) {
 	*out = *in
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CaSecret != nil {
 		in, out := &in.CaSecret, &out.CaSecret
 		*out = new(TlsSecret)
@@ -236,6 +295,196 @@ func (in *TlsInterfaceConfig) DeepCopy() *TlsInterfaceConfig {
 	return out
 }
 
+func (in *CertManagerConfig) DeepCopyInto(out *CertManagerConfig) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(CertManagerCAConfig)
+		**out = **in
+	}
+}
+
+func (in *CertManagerConfig) DeepCopy() *CertManagerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+}
+
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *CertManagerCAConfig) DeepCopyInto(out *CertManagerCAConfig) {
+	*out = *in
+}
+
+func (in *CertManagerCAConfig) DeepCopy() *CertManagerCAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerCAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenSearchClusterRef) DeepCopyInto(out *OpenSearchClusterRef) {
+	*out = *in
+}
+
+func (in *OpenSearchClusterRef) DeepCopy() *OpenSearchClusterRef {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchClusterRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenSearchIndexTemplateSpec) DeepCopyInto(out *OpenSearchIndexTemplateSpec) {
+	*out = *in
+	out.OpensearchRef = in.OpensearchRef
+}
+
+func (in *OpenSearchIndexTemplateSpec) DeepCopy() *OpenSearchIndexTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchIndexTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenSearchIndexTemplate) DeepCopyInto(out *OpenSearchIndexTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+func (in *OpenSearchIndexTemplate) DeepCopy() *OpenSearchIndexTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchIndexTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenSearchIndexTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OpenSearchIndexTemplateList) DeepCopyInto(out *OpenSearchIndexTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchIndexTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+func (in *OpenSearchIndexTemplateList) DeepCopy() *OpenSearchIndexTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchIndexTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenSearchIndexTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OpenSearchISMPolicySpec) DeepCopyInto(out *OpenSearchISMPolicySpec) {
+	*out = *in
+	out.OpensearchRef = in.OpensearchRef
+}
+
+func (in *OpenSearchISMPolicySpec) DeepCopy() *OpenSearchISMPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchISMPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenSearchISMPolicy) DeepCopyInto(out *OpenSearchISMPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+func (in *OpenSearchISMPolicy) DeepCopy() *OpenSearchISMPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchISMPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenSearchISMPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+func (in *OpenSearchISMPolicyList) DeepCopyInto(out *OpenSearchISMPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenSearchISMPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+func (in *OpenSearchISMPolicyList) DeepCopy() *OpenSearchISMPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenSearchISMPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *OpenSearchISMPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 func (in *TlsSecret) DeepCopyInto(out *TlsSecret) {
 	*out = *in
 	if in.Key != nil {
@@ -3,17 +3,20 @@ package controllers
 import (
 	"context"
 	"fmt"
-	"k8s.io/apimachinery/pkg/api/errors"
-	//v1 "k8s.io/client-go/applyconfigurations/core/v1"
 
 	sts "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	opsterv1 "opensearch.opster.io/api/v1"
 	"opensearch.opster.io/pkg/builders"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 type DashboardReconciler struct {
@@ -28,64 +31,156 @@ type DashboardReconciler struct {
 //+kubebuilder:rbac:groups=opensearch.opster.io,resources=opensearchcluster,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=opensearch.opster.io,resources=opensearchcluster/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=opensearch.opster.io,resources=opensearchcluster/finalizers,verbs=update
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 
+// Reconcile converges the Dashboards Deployment, ConfigMap, Service and (optionally) Ingress onto
+// the desired state on every pass, rather than only creating them once. Clusters that never opted
+// into Dashboards (Spec.Dashboards.Enable is false) get none of these, and any that were created
+// before Dashboards was disabled are cleaned up.
 func (r *DashboardReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
-	/// ------ create opensearch dashboard cm ------- ///
-
-	kibanaDeploy := sts.Deployment{}
 	deployName := r.Instance.Spec.General.ClusterName + "-dashboards"
 	deployNamespace := r.Instance.Spec.General.ClusterName
-	if err := r.Get(context.TODO(), client.ObjectKey{Name: deployName, Namespace: deployNamespace}, &kibanaDeploy); err != nil {
-		/// ------- create Opensearch-Dashboard deployment ------- ///
-		dashboards_deployment := builders.NewDashboardsDeploymentForCR(r.Instance)
-
-		err = r.Create(context.TODO(), dashboards_deployment)
-		if err != nil {
-			if !errors.IsAlreadyExists(err) {
-				fmt.Println(err, "Cannot create Opensearch-Dashboard Deployment "+dashboards_deployment.Name)
-				//	r.Recorder.Event(r.Instance, "Warning", "Cannot create OpenSearch-Dashboard deployment ", "Fix the problem you have on main Opensearch-Dashboard Deployment")
-				return ctrl.Result{}, err
-			}
-		}
-		fmt.Println("Opensearch-Dashboard Deployment Created successfully - ", "name : ", dashboards_deployment.Name)
+	cmName := "opensearch-dashboards"
+	serviceName := r.Instance.Spec.General.ServiceName + "-dash-svc"
+
+	if !r.Instance.Spec.Dashboards.Enable {
+		return ctrl.Result{}, r.cleanup(ctx, deployName, cmName, serviceName, deployNamespace)
 	}
 
-	kibanaCm := corev1.ConfigMap{}
-	cmName := "opensearch-dashboards"
-	if err := r.Get(context.TODO(), client.ObjectKey{Name: cmName, Namespace: deployNamespace}, &kibanaCm); err != nil {
-		/// ------- create Opensearch-Dashboard Configmap ------- ///
-		dashboards_cm := builders.NewDashboardsConfigMapForCR(r.Instance)
-
-		err = r.Create(context.TODO(), dashboards_cm)
-		if err != nil {
-			if !errors.IsAlreadyExists(err) {
-				fmt.Println(err, "Cannot create Opensearch-Dashboard Configmap "+dashboards_cm.Name)
-				//	r.Recorder.Event(r.Instance, "Warning", "Cannot create OpenSearch-Dashboard configmap ", "Fix the problem you have on main Opensearch-Dashboard ConfigMap")
-				return ctrl.Result{}, err
-			}
+	tlsSecrets, err := r.dashboardsTlsConfig()
+	if err != nil {
+		r.Recorder.Event(r.Instance, corev1.EventTypeWarning, "DashboardsTlsConfigFailed", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	kibanaCm := corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: cmName, Namespace: deployNamespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, &kibanaCm, func() error {
+		desired := builders.NewDashboardsConfigMapForCR(r.Instance, tlsSecrets)
+		kibanaCm.Data = desired.Data
+		return controllerutil.SetControllerReference(r.Instance, &kibanaCm, r.Scheme)
+	}); err != nil {
+		r.Recorder.Event(r.Instance, corev1.EventTypeWarning, "CannotReconcileDashboardsConfigMap", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	kibanaDeploy := sts.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deployName, Namespace: deployNamespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, &kibanaDeploy, func() error {
+		desired := builders.NewDashboardsDeploymentForCR(r.Instance)
+		kibanaDeploy.Spec = desired.Spec
+		kibanaDeploy.Labels = desired.Labels
+		return controllerutil.SetControllerReference(r.Instance, &kibanaDeploy, r.Scheme)
+	}); err != nil {
+		r.Recorder.Event(r.Instance, corev1.EventTypeWarning, "CannotReconcileDashboardsDeployment", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	kibanaService := corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: deployNamespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, &kibanaService, func() error {
+		desired := builders.NewDashboardsSvcForCr(r.Instance)
+		clusterIP := kibanaService.Spec.ClusterIP
+		kibanaService.Spec = desired.Spec
+		kibanaService.Spec.ClusterIP = clusterIP
+		kibanaService.Labels = desired.Labels
+		return controllerutil.SetControllerReference(r.Instance, &kibanaService, r.Scheme)
+	}); err != nil {
+		r.Recorder.Event(r.Instance, corev1.EventTypeWarning, "CannotReconcileDashboardsService", err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileIngress(ctx, serviceName, deployNamespace); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// cleanup removes any Dashboards child objects a previous reconcile created, for a cluster that
+// now has Dashboards disabled.
+func (r *DashboardReconciler) cleanup(ctx context.Context, deployName string, cmName string, serviceName string, namespace string) error {
+	objs := []client.Object{
+		&sts.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deployName, Namespace: namespace}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: cmName, Namespace: namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace}},
+		&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: deployName, Namespace: namespace}},
+	}
+	for _, obj := range objs {
+		if err := client.IgnoreNotFound(r.Delete(ctx, obj)); err != nil {
+			r.Recorder.Event(r.Instance, corev1.EventTypeWarning, "CannotCleanupDashboardsResource", err.Error())
+			return err
 		}
-		fmt.Println("Opensearch-Dashboard Cm Created successfully", "name", dashboards_cm.Name)
+	}
+	return nil
+}
 
+// dashboardsTlsConfig derives the opensearch.ssl.*, server.ssl.* and opensearch.username/password
+// settings that go into opensearch_dashboards.yml from the same Secrets TlsReconciler populates, so
+// Dashboards trusts the cluster's CA and authenticates without a separate set of credentials to manage.
+func (r *DashboardReconciler) dashboardsTlsConfig() (*builders.DashboardsTlsConfig, error) {
+	clusterName := r.Instance.Spec.General.ClusterName
+	username, password, err := r.adminCredentials(clusterName)
+	if err != nil {
+		return nil, err
 	}
 
-	kibanaService := corev1.Service{}
-	serviceName := r.Instance.Spec.General.ServiceName + "-dash-svc"
+	security := r.Instance.Spec.Security
+	if security == nil || security.Tls == nil || security.Tls.Http == nil {
+		return &builders.DashboardsTlsConfig{VerificationMode: "none", Username: username, Password: password}, nil
+	}
 
-	if err := r.Get(context.TODO(), client.ObjectKey{Name: serviceName, Namespace: deployNamespace}, &kibanaService); err != nil {
-		/// -------- create Opensearch-Dashboard service ------- ///
-		dashboards_svc := builders.NewDashboardsSvcForCr(r.Instance)
-		err = r.Create(context.TODO(), dashboards_svc)
-		if err != nil {
-			if !errors.IsAlreadyExists(err) {
-				fmt.Println(err, "Cannot create Opensearch-Dashboard service "+dashboards_svc.Name)
-				// 	r.Recorder.Event(r.Instance, "Warning", "Cannot create OpenSearch-Dashboard service ", "Fix the problem you have on main Opensearch-Dashboard Service")
-				return ctrl.Result{}, err
-			}
+	caSecretName := clusterName + "-http-cert"
+	caSecret := corev1.Secret{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: caSecretName, Namespace: clusterName}, &caSecret); err != nil {
+		return nil, fmt.Errorf("failed to load http TLS secret for dashboards config: %w", err)
+	}
+	return &builders.DashboardsTlsConfig{
+		VerificationMode: "full",
+		CaCertSecret:     caSecretName,
+		ServerSslEnabled: true,
+		ServerCertSecret: caSecretName,
+		Username:         username,
+		Password:         password,
+	}, nil
+}
+
+// adminCredentials loads the admin username/password TlsReconciler provisions into
+// "<clusterName>-admin-credentials" alongside the TLS secrets, so Dashboards can authenticate to
+// the secured OpenSearch API without a separate credential-management path.
+func (r *DashboardReconciler) adminCredentials(clusterName string) (string, string, error) {
+	credsSecretName := clusterName + "-admin-credentials"
+	credsSecret := corev1.Secret{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: credsSecretName, Namespace: clusterName}, &credsSecret); err != nil {
+		return "", "", fmt.Errorf("failed to load admin credentials secret for dashboards config: %w", err)
+	}
+	return string(credsSecret.Data["username"]), string(credsSecret.Data["password"]), nil
+}
+
+// reconcileIngress materialises an Ingress for Dashboards when Spec.Dashboards.Ingress is set, and
+// removes any previously-created one when the field is cleared.
+func (r *DashboardReconciler) reconcileIngress(ctx context.Context, serviceName string, namespace string) error {
+	ingressName := r.Instance.Spec.General.ClusterName + "-dashboards"
+	ingressSpec := r.Instance.Spec.Dashboards.Ingress
+
+	if ingressSpec == nil {
+		existing := networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: ingressName, Namespace: namespace}}
+		if err := client.IgnoreNotFound(r.Delete(ctx, &existing)); err != nil {
+			r.Recorder.Event(r.Instance, corev1.EventTypeWarning, "CannotDeleteDashboardsIngress", err.Error())
+			return err
 		}
-		fmt.Println("Opensearch-Dashboard service Created successfully", "name", dashboards_svc.Name)
+		return nil
 	}
 
-	return ctrl.Result{}, nil
+	ingress := networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: ingressName, Namespace: namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, &ingress, func() error {
+		desired := builders.NewDashboardsIngressForCR(r.Instance, serviceName)
+		ingress.Spec = desired.Spec
+		ingress.Annotations = desired.Annotations
+		ingress.Labels = desired.Labels
+		return controllerutil.SetControllerReference(r.Instance, &ingress, r.Scheme)
+	}); err != nil {
+		r.Recorder.Event(r.Instance, corev1.EventTypeWarning, "CannotReconcileDashboardsIngress", err.Error())
+		return err
+	}
+	return nil
 }
 
 func (r *DashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -94,5 +189,21 @@ func (r *DashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&opsterv1.OpenSearchCluster{}).
+		Owns(&sts.Deployment{}).
+		Owns(&corev1.ConfigMap{}).
+		Owns(&corev1.Service{}).
+		Owns(&networkingv1.Ingress{}).
+		// Generate-path CA/cert Secrets are created by TlsReconciler with SetControllerReference
+		// back to the cluster, so Owns() catches edits to them and re-renders the Dashboards
+		// ConfigMap that embeds their contents.
+		Owns(&corev1.Secret{}).
+		// User-managed TLS secrets aren't owned by the cluster (we never created them), so an
+		// Owns() watch won't catch edits to them; map by label instead. This is what makes
+		// rotating an externally-managed CaSecret/CertSecret/KeySecret re-render the Dashboards
+		// ConfigMap and flow through to a StatefulSet restart via the cluster controller.
+		Watches(
+			&source.Kind{Type: &corev1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(SecretToClusterRequest),
+		).
 		Complete(r)
 }
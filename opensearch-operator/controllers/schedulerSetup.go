@@ -0,0 +1,19 @@
+package controllers
+
+import (
+	"github.com/go-logr/logr"
+	"opensearch.opster.io/pkg/scheduler"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupScheduler registers the background sync jobs (cluster health, index templates, ISM
+// policies) and adds the scheduler to the manager as a Runnable so it starts and stops with the
+// rest of the operator. Scheduler implements manager.LeaderElectionRunnable, so controller-runtime
+// only starts it on the replica that already holds the manager's own leader-election lock.
+func SetupScheduler(mgr ctrl.Manager, logger logr.Logger) error {
+	s := scheduler.New(logger)
+	s.Register(&scheduler.ClusterHealthSync{Client: mgr.GetClient(), Logger: logger})
+	s.Register(&scheduler.IndexTemplateSync{Client: mgr.GetClient(), Logger: logger})
+	s.Register(&scheduler.ISMPolicySync{Client: mgr.GetClient(), Logger: logger})
+	return mgr.Add(s)
+}
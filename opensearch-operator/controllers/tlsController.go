@@ -5,18 +5,28 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/record"
 	opsterv1 "opensearch.opster.io/api/v1"
 	tls "opensearch.opster.io/pkg/tls"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 type TlsReconciler struct {
 	client.Client
+	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
 	logr.Logger
 	Instance *opsterv1.OpenSearchCluster
@@ -36,6 +46,12 @@ func (r *TlsReconciler) Reconcile(controllerContext *ControllerContext) (*opster
 	if err := r.HandleInterface("http", tlsConfig.Http, controllerContext, &nodesDn); err != nil {
 		return nil, err
 	}
+	if err := r.checkAndRotateCerts("transport", tlsConfig.Transport, tlsConfig.RenewBefore); err != nil {
+		return nil, err
+	}
+	if err := r.checkAndRotateCerts("http", tlsConfig.Http, tlsConfig.RenewBefore); err != nil {
+		return nil, err
+	}
 	if len(nodesDn) > 0 {
 		dnList := strings.Join(nodesDn, "\",\"")
 		controllerContext.AddConfig("plugins.security.nodes_dn", fmt.Sprintf("[\"%s\"]", dnList))
@@ -54,7 +70,32 @@ func (r *TlsReconciler) HandleInterface(name string, config *opsterv1.TlsInterfa
 	ca_secret_name := clusterName + "-ca"
 	node_secret_name := clusterName + "-" + name + "-cert"
 
-	if config.Generate {
+	if config.CertManager != nil && config.PerNode {
+		err := errors.New("certManager and perNode are not supported together")
+		r.Logger.Error(err, "Invalid TLS config", "interface", name)
+		return err
+	}
+
+	if config.CertManager != nil {
+		r.Logger.Info("Requesting certificate from cert-manager", "interface", name)
+		if err := r.handleCertManager(name, config.CertManager, node_secret_name, ca_secret_name, namespace, clusterName); err != nil {
+			return err
+		}
+		mount(name, "ca", "ca.crt", &opsterv1.TlsSecret{SecretName: node_secret_name}, controllerContext)
+		mount(name, "key", "tls.key", &opsterv1.TlsSecret{SecretName: node_secret_name}, controllerContext)
+		mount(name, "cert", "tls.crt", &opsterv1.TlsSecret{SecretName: node_secret_name}, controllerContext)
+		if name == "transport" {
+			*nodesDn = append(*nodesDn, fmt.Sprintf("CN=%s", clusterName))
+		}
+	} else if config.Generate && config.PerNode {
+		r.Logger.Info("Generating per-node certificates", "interface", name)
+		if err := r.handlePerNodeCerts(name, node_secret_name, ca_secret_name, namespace, clusterName, controllerContext); err != nil {
+			return err
+		}
+		if name == "transport" {
+			*nodesDn = append(*nodesDn, fmt.Sprintf("CN=%s", clusterName))
+		}
+	} else if config.Generate {
 		r.Logger.Info("Generating certificates", "interface", name)
 		// Check for existing CA secret
 		caSecret := corev1.Secret{}
@@ -67,6 +108,9 @@ func (r *TlsReconciler) HandleInterface(name string, config *opsterv1.TlsInterfa
 				return err
 			}
 			caSecret = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ca_secret_name, Namespace: namespace}, Data: ca.SecretDataCA()}
+			if err := controllerutil.SetControllerReference(r.Instance, &caSecret, r.Scheme); err != nil {
+				return err
+			}
 			if err := r.Create(context.TODO(), &caSecret); err != nil {
 				r.Logger.Error(err, "Failed to store CA in secret", "interface", name)
 				return err
@@ -91,6 +135,9 @@ func (r *TlsReconciler) HandleInterface(name string, config *opsterv1.TlsInterfa
 				return err
 			}
 			nodeSecret = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: node_secret_name, Namespace: namespace}, Data: nodeCert.SecretData(&ca)}
+			if err := controllerutil.SetControllerReference(r.Instance, &nodeSecret, r.Scheme); err != nil {
+				return err
+			}
 			if err := r.Create(context.TODO(), &nodeSecret); err != nil {
 				r.Logger.Error(err, "Failed to store node certificate in secret", "interface", name)
 				return err
@@ -113,13 +160,24 @@ func (r *TlsReconciler) HandleInterface(name string, config *opsterv1.TlsInterfa
 		mount(name, "ca", "ca.crt", config.CaSecret, controllerContext)
 		mount(name, "key", "tls.key", config.KeySecret, controllerContext)
 		mount(name, "cert", "tls.crt", config.CertSecret, controllerContext)
+		for _, secret := range []*opsterv1.TlsSecret{config.CaSecret, config.CertSecret, config.KeySecret} {
+			if err := r.labelUserProvidedSecret(secret.SecretName, namespace); err != nil {
+				r.Logger.Error(err, "Failed to label user-provided TLS secret", "interface", name, "secret", secret.SecretName)
+				return err
+			}
+		}
 	}
 	// Extend opensearch.yml
 	if name == "transport" {
 		controllerContext.AddConfig("plugins.security.ssl.transport.pemcert_filepath", "tls-transport/tls.crt")
 		controllerContext.AddConfig("plugins.security.ssl.transport.pemkey_filepath", "tls-transport/tls.key")
 		controllerContext.AddConfig("plugins.security.ssl.transport.pemtrustedcas_filepath", "tls-transport/ca.crt")
-		controllerContext.AddConfig("plugins.security.ssl.transport.enforce_hostname_verification", "false") // TODO: Enable with per-node certificates
+		if config.PerNode {
+			controllerContext.AddConfig("plugins.security.ssl.transport.enforce_hostname_verification", "true")
+			controllerContext.AddConfig("plugins.security.ssl.transport.resolve_hostname", "false")
+		} else {
+			controllerContext.AddConfig("plugins.security.ssl.transport.enforce_hostname_verification", "false")
+		}
 	} else if name == "http" {
 		controllerContext.AddConfig("plugins.security.ssl.http.enabled", "true")
 		controllerContext.AddConfig("plugins.security.ssl.http.pemcert_filepath", "tls-http/tls.crt")
@@ -129,6 +187,231 @@ func (r *TlsReconciler) HandleInterface(name string, config *opsterv1.TlsInterfa
 	return nil
 }
 
+// handleCertManager requests node_secret_name (and, if config.CA is set, ca_secret_name as well)
+// as cert-manager Certificates and waits for cert-manager to populate their Secrets.
+func (r *TlsReconciler) handleCertManager(name string, config *opsterv1.CertManagerConfig, node_secret_name string, ca_secret_name string, namespace string, clusterName string) error {
+	if config.CA != nil {
+		caCert := r.certificateFor(ca_secret_name, namespace, clusterName, config.IssuerRef, []string{clusterName}, true)
+		if err := r.createCertificateIfNotExists(caCert); err != nil {
+			r.Logger.Error(err, "Failed to request CA certificate from cert-manager", "interface", name)
+			return err
+		}
+		if err := r.waitForSecretPopulated(ca_secret_name, namespace); err != nil {
+			r.Logger.Error(err, "Timed out waiting for cert-manager to populate CA secret", "interface", name)
+			return err
+		}
+	}
+
+	dnsNames := []string{
+		clusterName,
+		fmt.Sprintf("%s.%s", clusterName, namespace),
+		fmt.Sprintf("%s.%s.svc", clusterName, namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", clusterName, namespace),
+	}
+	nodeCert := r.certificateFor(node_secret_name, namespace, clusterName, config.IssuerRef, dnsNames, false)
+	if err := r.createCertificateIfNotExists(nodeCert); err != nil {
+		r.Logger.Error(err, "Failed to request certificate from cert-manager", "interface", name)
+		return err
+	}
+	return r.waitForSecretPopulated(node_secret_name, namespace)
+}
+
+// certificateFor builds a cert-manager Certificate requesting secretName, either as a CA
+// (isCA) or as a leaf certificate covering dnsNames.
+func (r *TlsReconciler) certificateFor(secretName string, namespace string, clusterName string, issuerRef opsterv1.CertManagerIssuerRef, dnsNames []string, isCA bool) *certmanagerv1.Certificate {
+	kind := issuerRef.Kind
+	if kind == "" {
+		kind = "Issuer"
+	}
+	return &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace},
+		Spec: certmanagerv1.CertificateSpec{
+			SecretName: secretName,
+			CommonName: clusterName,
+			DNSNames:   dnsNames,
+			IsCA:       isCA,
+			IssuerRef: cmmeta.ObjectReference{
+				Name: issuerRef.Name,
+				Kind: kind,
+			},
+		},
+	}
+}
+
+func (r *TlsReconciler) createCertificateIfNotExists(cert *certmanagerv1.Certificate) error {
+	existing := certmanagerv1.Certificate{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: cert.Name, Namespace: cert.Namespace}, &existing); err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := controllerutil.SetControllerReference(r.Instance, cert, r.Scheme); err != nil {
+		return err
+	}
+	return r.Create(context.TODO(), cert)
+}
+
+// waitForSecretPopulated polls until cert-manager has written the certificate data into
+// secretName, or times out.
+func (r *TlsReconciler) waitForSecretPopulated(secretName string, namespace string) error {
+	return wait.PollImmediate(2*time.Second, 2*time.Minute, func() (bool, error) {
+		secret := corev1.Secret{}
+		if err := r.Get(context.TODO(), client.ObjectKey{Name: secretName, Namespace: namespace}, &secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		_, ok := secret.Data["tls.crt"]
+		return ok, nil
+	})
+}
+
+// handlePerNodeCerts issues one leaf certificate per OpenSearch node (instead of a single
+// cluster-wide certificate) and stores them all in one Secret, keyed by pod name. The pod spec
+// mounts the entry matching its own pod via $(POD_NAME), which the cluster reconciler must
+// resolve through the downward API (see ensurePodNameEnv).
+func (r *TlsReconciler) handlePerNodeCerts(name string, node_secret_name string, ca_secret_name string, namespace string, clusterName string, controllerContext *ControllerContext) error {
+	caSecret := corev1.Secret{}
+	var ca tls.Cert
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: ca_secret_name, Namespace: namespace}, &caSecret); err != nil {
+		var genErr error
+		ca, genErr = tls.GenerateCA(clusterName)
+		if genErr != nil {
+			r.Logger.Error(genErr, "Failed to create CA", "interface", name)
+			return genErr
+		}
+		caSecret = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ca_secret_name, Namespace: namespace}, Data: ca.SecretDataCA()}
+		if err := controllerutil.SetControllerReference(r.Instance, &caSecret, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(context.TODO(), &caSecret); err != nil {
+			r.Logger.Error(err, "Failed to store CA in secret", "interface", name)
+			return err
+		}
+	} else {
+		ca = tls.CAFromSecret(caSecret.Data)
+	}
+
+	discoveryServiceName := clusterName + "-discovery"
+	nodeSecret := corev1.Secret{}
+	nodeSecretExists := r.Get(context.TODO(), client.ObjectKey{Name: node_secret_name, Namespace: namespace}, &nodeSecret) == nil
+	if !nodeSecretExists {
+		nodeSecret = corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: node_secret_name, Namespace: namespace}, Data: map[string][]byte{"ca.crt": ca.SecretDataCA()["ca.crt"]}}
+	}
+	if nodeSecret.Data == nil {
+		nodeSecret.Data = map[string][]byte{}
+	}
+
+	for _, pool := range r.Instance.Spec.NodePools {
+		stsName := clusterName + "-" + pool.Component
+		for i := int32(0); i < pool.Replicas; i++ {
+			podName := fmt.Sprintf("%s-%d", stsName, i)
+			if _, ok := nodeSecret.Data[podName+".crt"]; ok {
+				continue
+			}
+			dnsNames := perNodeDNSNames(clusterName, namespace, discoveryServiceName, stsName, podName)
+			nodeCert, err := ca.CreateAndSignCertificate(podName, dnsNames)
+			if err != nil {
+				r.Logger.Error(err, "Failed to create per-node certificate", "interface", name, "pod", podName)
+				return err
+			}
+			certData := nodeCert.SecretData(&ca)
+			nodeSecret.Data[podName+".crt"] = certData["tls.crt"]
+			nodeSecret.Data[podName+".key"] = certData["tls.key"]
+		}
+	}
+
+	if nodeSecretExists {
+		if err := r.Update(context.TODO(), &nodeSecret); err != nil {
+			r.Logger.Error(err, "Failed to update per-node certificate secret", "interface", name)
+			return err
+		}
+	} else {
+		if err := controllerutil.SetControllerReference(r.Instance, &nodeSecret, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(context.TODO(), &nodeSecret); err != nil {
+			r.Logger.Error(err, "Failed to store per-node certificate secret", "interface", name)
+			return err
+		}
+	}
+
+	volume := corev1.Volume{Name: name + "-cert", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: node_secret_name}}}
+	controllerContext.Volumes = append(controllerContext.Volumes, volume)
+	mountPath := "/usr/share/opensearch/config/tls-" + name
+	controllerContext.VolumeMounts = append(controllerContext.VolumeMounts,
+		corev1.VolumeMount{Name: name + "-cert", MountPath: mountPath + "/ca.crt", SubPath: "ca.crt"},
+		corev1.VolumeMount{Name: name + "-cert", MountPath: mountPath + "/tls.crt", SubPathExpr: "$(POD_NAME).crt"},
+		corev1.VolumeMount{Name: name + "-cert", MountPath: mountPath + "/tls.key", SubPathExpr: "$(POD_NAME).key"},
+	)
+	ensurePodNameEnv(controllerContext)
+	return nil
+}
+
+// perNodeDNSNames returns the SANs a per-node certificate for podName needs in order to satisfy
+// OpenSearch transport hostname verification against the discovery Service, the StatefulSet's
+// stable network identity and the plain pod name.
+func perNodeDNSNames(clusterName string, namespace string, discoveryServiceName string, stsName string, podName string) []string {
+	return []string{
+		podName,
+		fmt.Sprintf("%s.%s", podName, discoveryServiceName),
+		fmt.Sprintf("%s.%s.%s.svc.cluster.local", podName, discoveryServiceName, namespace),
+		stsName,
+		clusterName,
+	}
+}
+
+// ensurePodNameEnv makes sure the pod template gets a POD_NAME env var sourced from the downward
+// API, so that $(POD_NAME) in a per-node cert's SubPathExpr resolves to the pod's own name. It is
+// idempotent: calling it for both the transport and http interfaces only adds the var once.
+func ensurePodNameEnv(controllerContext *ControllerContext) {
+	for _, env := range controllerContext.Env {
+		if env.Name == "POD_NAME" {
+			return
+		}
+	}
+	controllerContext.Env = append(controllerContext.Env, corev1.EnvVar{
+		Name:      "POD_NAME",
+		ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"}},
+	})
+}
+
+// clusterLabel is stamped onto every user-provided TLS Secret the operator is told to mount, so a
+// Watches handler on the parent cluster controller can map Secret events back to the owning
+// OpenSearchCluster even though the Secret itself has no OwnerReference to it.
+const clusterLabel = "opster.io/cluster"
+
+// labelUserProvidedSecret ensures an externally-managed TLS Secret carries clusterLabel, patching
+// it in if missing. Without this, rotating a CaSecret/CertSecret/KeySecret the user manages
+// themselves (e.g. via cert-manager or a PKI pipeline outside this operator) would never trigger a
+// re-sync of the StatefulSet pod annotations that make kubelet remount it.
+func (r *TlsReconciler) labelUserProvidedSecret(secretName string, namespace string) error {
+	secret := corev1.Secret{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: secretName, Namespace: namespace}, &secret); err != nil {
+		return err
+	}
+	if secret.Labels[clusterLabel] == r.Instance.Spec.General.ClusterName {
+		return nil
+	}
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[clusterLabel] = r.Instance.Spec.General.ClusterName
+	return r.Update(context.TODO(), &secret)
+}
+
+// SecretToClusterRequest maps a Secret carrying clusterLabel back to a reconcile.Request for its
+// owning OpenSearchCluster. Wired into the parent cluster controller's SetupWithManager via
+// Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(SecretToClusterRequest)).
+func SecretToClusterRequest(obj client.Object) []reconcile.Request {
+	clusterName, ok := obj.GetLabels()[clusterLabel]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: obj.GetNamespace()}}}
+}
+
 func mount(interfaceName string, name string, filename string, secret *opsterv1.TlsSecret, controllerContext *ControllerContext) {
 	volume := corev1.Volume{Name: interfaceName + "-" + name, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secret.SecretName}}}
 	controllerContext.Volumes = append(controllerContext.Volumes, volume)
@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	opsterv1 "opensearch.opster.io/api/v1"
+)
+
+// Regression test for the chunk0-1/chunk0-2 review fix: CertManager and PerNode both provision
+// (and mount) the transport certificate differently, so configuring both must be rejected before
+// any certificate is requested rather than silently producing a mount OpenSearch can't find.
+func TestHandleInterfaceRejectsCertManagerWithPerNode(t *testing.T) {
+	r := &TlsReconciler{
+		Logger: logr.Discard(),
+		Instance: &opsterv1.OpenSearchCluster{
+			Spec: opsterv1.ClusterSpec{
+				General: opsterv1.GeneralConfig{ClusterName: "test-cluster"},
+			},
+		},
+	}
+	config := &opsterv1.TlsInterfaceConfig{
+		PerNode:     true,
+		CertManager: &opsterv1.CertManagerConfig{IssuerRef: opsterv1.CertManagerIssuerRef{Name: "my-issuer"}},
+	}
+	nodesDn := []string{}
+
+	err := r.HandleInterface("transport", config, &ControllerContext{}, &nodesDn)
+	if err == nil {
+		t.Fatal("expected HandleInterface to reject CertManager combined with PerNode, got nil error")
+	}
+}
@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	opsterv1 "opensearch.opster.io/api/v1"
+	tls "opensearch.opster.io/pkg/tls"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultRenewBefore = 30 * 24 * time.Hour
+
+// checkAndRotateCerts rotates self-signed certificates (Generate) for the given interface once
+// they are within renewBefore of expiring. CertManager-sourced and user-supplied certificates are
+// left alone: cert-manager renews its own certificates, and users own the lifecycle of theirs.
+func (r *TlsReconciler) checkAndRotateCerts(name string, config *opsterv1.TlsInterfaceConfig, renewBefore *metav1.Duration) error {
+	if config == nil || !config.Generate || config.CertManager != nil {
+		return nil
+	}
+	namespace := r.Instance.Spec.General.ClusterName
+	clusterName := r.Instance.Spec.General.ClusterName
+	ca_secret_name := clusterName + "-ca"
+	node_secret_name := clusterName + "-" + name + "-cert"
+
+	renew := defaultRenewBefore
+	if renewBefore != nil {
+		renew = renewBefore.Duration
+	}
+
+	if config.PerNode {
+		return r.checkAndRotatePerNodeCerts(name, node_secret_name, ca_secret_name, namespace, clusterName, renew)
+	}
+	return r.checkAndRotateSharedCert(name, node_secret_name, ca_secret_name, namespace, clusterName, renew)
+}
+
+// checkAndRotateSharedCert re-signs the single cluster-wide leaf certificate stored in
+// node_secret_name when it is close to expiry, reusing the interface's CA.
+func (r *TlsReconciler) checkAndRotateSharedCert(name string, node_secret_name string, ca_secret_name string, namespace string, clusterName string, renewBefore time.Duration) error {
+	nodeSecret := corev1.Secret{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: node_secret_name, Namespace: namespace}, &nodeSecret); err != nil {
+		return nil
+	}
+	expiring, err := certExpiringWithin(nodeSecret.Data["tls.crt"], renewBefore)
+	if err != nil || !expiring {
+		return err
+	}
+
+	caSecret := corev1.Secret{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: ca_secret_name, Namespace: namespace}, &caSecret); err != nil {
+		r.Logger.Error(err, "Cannot rotate certificate: CA secret missing", "interface", name)
+		return err
+	}
+	ca := tls.CAFromSecret(caSecret.Data)
+
+	dnsNames := []string{
+		clusterName,
+		fmt.Sprintf("%s.%s", clusterName, namespace),
+		fmt.Sprintf("%s.%s.svc", clusterName, namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", clusterName, namespace),
+	}
+	nodeCert, err := ca.CreateAndSignCertificate(clusterName, dnsNames)
+	if err != nil {
+		r.Logger.Error(err, "Failed to rotate certificate", "interface", name)
+		return err
+	}
+	nodeSecret.Data = nodeCert.SecretData(&ca)
+	if err := r.Update(context.TODO(), &nodeSecret); err != nil {
+		r.Logger.Error(err, "Failed to store rotated certificate", "interface", name)
+		return err
+	}
+	r.Logger.Info("Rotated certificate", "interface", name)
+	return r.restartDependentStatefulSets(clusterName)
+}
+
+// checkAndRotatePerNodeCerts re-signs any per-node leaf certificate in node_secret_name that is
+// close to expiry. Each leaf is re-signed with the same SANs it was originally issued with -
+// including the discovery-service and StatefulSet identities, not just the bare pod name -
+// otherwise transport hostname verification (enabled for per-node certs) breaks on the node whose
+// cert just rotated.
+func (r *TlsReconciler) checkAndRotatePerNodeCerts(name string, node_secret_name string, ca_secret_name string, namespace string, clusterName string, renewBefore time.Duration) error {
+	nodeSecret := corev1.Secret{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: node_secret_name, Namespace: namespace}, &nodeSecret); err != nil {
+		return nil
+	}
+	caSecret := corev1.Secret{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: ca_secret_name, Namespace: namespace}, &caSecret); err != nil {
+		r.Logger.Error(err, "Cannot rotate per-node certificates: CA secret missing", "interface", name)
+		return err
+	}
+	ca := tls.CAFromSecret(caSecret.Data)
+	discoveryServiceName := clusterName + "-discovery"
+
+	rotated := false
+	for _, pool := range r.Instance.Spec.NodePools {
+		stsName := clusterName + "-" + pool.Component
+		for i := int32(0); i < pool.Replicas; i++ {
+			podName := fmt.Sprintf("%s-%d", stsName, i)
+			certKey := podName + ".crt"
+			expiring, err := certExpiringWithin(nodeSecret.Data[certKey], renewBefore)
+			if err != nil || !expiring {
+				continue
+			}
+			dnsNames := perNodeDNSNames(clusterName, namespace, discoveryServiceName, stsName, podName)
+			nodeCert, err := ca.CreateAndSignCertificate(podName, dnsNames)
+			if err != nil {
+				r.Logger.Error(err, "Failed to rotate per-node certificate", "interface", name, "pod", podName)
+				return err
+			}
+			certData := nodeCert.SecretData(&ca)
+			nodeSecret.Data[certKey] = certData["tls.crt"]
+			nodeSecret.Data[podName+".key"] = certData["tls.key"]
+			rotated = true
+		}
+	}
+	if !rotated {
+		return nil
+	}
+	if err := r.Update(context.TODO(), &nodeSecret); err != nil {
+		r.Logger.Error(err, "Failed to store rotated per-node certificates", "interface", name)
+		return err
+	}
+	r.Logger.Info("Rotated per-node certificates", "interface", name)
+	return r.restartDependentStatefulSets(clusterName)
+}
+
+// restartDependentStatefulSets triggers a rolling restart of every NodePool's StatefulSet so
+// pods pick up the certificate that was just rotated into their mounted Secret. Kubernetes does
+// not restart running pods on its own when a mounted Secret changes.
+func (r *TlsReconciler) restartDependentStatefulSets(clusterName string) error {
+	for _, pool := range r.Instance.Spec.NodePools {
+		sts := appsv1.StatefulSet{}
+		key := client.ObjectKey{Name: clusterName + "-" + pool.Component, Namespace: clusterName}
+		if err := r.Get(context.TODO(), key, &sts); err != nil {
+			continue
+		}
+		if sts.Spec.Template.Annotations == nil {
+			sts.Spec.Template.Annotations = map[string]string{}
+		}
+		sts.Spec.Template.Annotations["opster.io/tls-rotated-at"] = metav1.Now().Format(time.RFC3339)
+		if err := r.Update(context.TODO(), &sts); err != nil {
+			r.Logger.Error(err, "Failed to restart StatefulSet after certificate rotation", "statefulSet", sts.Name)
+			return err
+		}
+	}
+	return nil
+}
+
+// certExpiringWithin reports whether certPEM's leaf certificate expires within renewBefore.
+func certExpiringWithin(certPEM []byte, renewBefore time.Duration) (bool, error) {
+	if len(certPEM) == 0 {
+		return false, nil
+	}
+	notAfter, err := certNotAfter(certPEM)
+	if err != nil {
+		return false, err
+	}
+	return time.Until(notAfter) < renewBefore, nil
+}
+
+func certNotAfter(certPEM []byte) (time.Time, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return time.Time{}, errors.New("failed to decode PEM certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	opsterv1 "opensearch.opster.io/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// A cluster with no NodePools has no per-node certificates to rotate; checkAndRotatePerNodeCerts
+// should find nothing expiring and return without writing anything back.
+func TestCheckAndRotatePerNodeCertsNoOpWithoutNodePools(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := opsterv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster-ca", Namespace: "test-cluster"},
+		Data:       map[string][]byte{"ca.crt": []byte("ca"), "ca.key": []byte("key")},
+	}
+	nodeSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster-transport-cert", Namespace: "test-cluster"},
+		Data:       map[string][]byte{},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(caSecret, nodeSecret).Build()
+
+	r := &TlsReconciler{
+		Client:   fakeClient,
+		Logger:   logr.Discard(),
+		Recorder: record.NewFakeRecorder(10),
+		Instance: &opsterv1.OpenSearchCluster{
+			Spec: opsterv1.ClusterSpec{General: opsterv1.GeneralConfig{ClusterName: "test-cluster"}},
+		},
+	}
+
+	err := r.checkAndRotatePerNodeCerts("transport", "test-cluster-transport-cert", "test-cluster-ca", "test-cluster", "test-cluster", 30*24*time.Hour)
+	if err != nil {
+		t.Fatalf("expected no error when there are no NodePools to rotate certificates for, got %v", err)
+	}
+}
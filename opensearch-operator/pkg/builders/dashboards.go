@@ -1,13 +1,29 @@
 package builders
 
 import (
+	"fmt"
+
 	sts "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	opsterv1 "opensearch.opster.io/api/v1"
 )
 
+// DashboardsTlsConfig carries the settings NewDashboardsConfigMapForCR needs to render the
+// opensearch.ssl.* and server.ssl.* sections of opensearch_dashboards.yml, and the credentials
+// Dashboards authenticates to OpenSearch with.
+type DashboardsTlsConfig struct {
+	// VerificationMode is "full" or "none".
+	VerificationMode string
+	CaCertSecret     string
+	ServerSslEnabled bool
+	ServerCertSecret string
+	Username         string
+	Password         string
+}
+
 // NewDashboardsDeploymentForCR builds the Dashboards Deployment for cr.
 func NewDashboardsDeploymentForCR(cr *opsterv1.OpenSearchCluster) *sts.Deployment {
 	return &sts.Deployment{
@@ -32,8 +48,22 @@ func NewDashboardsDeploymentForCR(cr *opsterv1.OpenSearchCluster) *sts.Deploymen
 	}
 }
 
-// NewDashboardsConfigMapForCR builds the opensearch_dashboards.yml ConfigMap for cr.
-func NewDashboardsConfigMapForCR(cr *opsterv1.OpenSearchCluster) *corev1.ConfigMap {
+// NewDashboardsConfigMapForCR builds the opensearch_dashboards.yml ConfigMap for cr, wiring in
+// tlsSecrets so Dashboards trusts the cluster's CA and authenticates with its own credentials.
+func NewDashboardsConfigMapForCR(cr *opsterv1.OpenSearchCluster, tlsSecrets *DashboardsTlsConfig) *corev1.ConfigMap {
+	yaml := fmt.Sprintf("opensearch.hosts: [\"https://%s\"]\n", cr.Spec.General.ServiceName)
+	yaml += fmt.Sprintf("opensearch.ssl.verificationMode: %s\n", tlsSecrets.VerificationMode)
+	if tlsSecrets.CaCertSecret != "" {
+		yaml += "opensearch.ssl.certificateAuthorities: [/usr/share/opensearch-dashboards/certs/ca.crt]\n"
+	}
+	yaml += fmt.Sprintf("server.ssl.enabled: %t\n", tlsSecrets.ServerSslEnabled)
+	if tlsSecrets.ServerCertSecret != "" {
+		yaml += "server.ssl.certificate: /usr/share/opensearch-dashboards/certs/tls.crt\n"
+		yaml += "server.ssl.key: /usr/share/opensearch-dashboards/certs/tls.key\n"
+	}
+	yaml += fmt.Sprintf("opensearch.username: %s\n", tlsSecrets.Username)
+	yaml += fmt.Sprintf("opensearch.password: %s\n", tlsSecrets.Password)
+
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "opensearch-dashboards",
@@ -41,7 +71,7 @@ func NewDashboardsConfigMapForCR(cr *opsterv1.OpenSearchCluster) *corev1.ConfigM
 			Labels:    dashboardsLabels(cr),
 		},
 		Data: map[string]string{
-			"opensearch_dashboards.yml": "opensearch.hosts: [\"https://" + cr.Spec.General.ServiceName + "\"]\n",
+			"opensearch_dashboards.yml": yaml,
 		},
 	}
 }
@@ -61,6 +91,58 @@ func NewDashboardsSvcForCr(cr *opsterv1.OpenSearchCluster) *corev1.Service {
 	}
 }
 
+// NewDashboardsIngressForCR builds the Ingress exposing serviceName, as configured by
+// cr.Spec.Dashboards.Ingress. Callers must check that field is non-nil before calling this.
+func NewDashboardsIngressForCR(cr *opsterv1.OpenSearchCluster, serviceName string) *networkingv1.Ingress {
+	spec := cr.Spec.Dashboards.Ingress
+	pathType := networkingv1.PathTypePrefix
+
+	rule := networkingv1.IngressRule{
+		Host: spec.Host,
+		IngressRuleValue: networkingv1.IngressRuleValue{
+			HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{{
+					Path:     "/",
+					PathType: &pathType,
+					Backend: networkingv1.IngressBackend{
+						Service: &networkingv1.IngressServiceBackend{
+							Name: serviceName,
+							Port: networkingv1.ServiceBackendPort{Number: 5601},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cr.Spec.General.ClusterName + "-dashboards",
+			Namespace:   cr.Spec.General.ClusterName,
+			Labels:      dashboardsLabels(cr),
+			Annotations: spec.Annotations,
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: spec.IngressClassName,
+			Rules:            []networkingv1.IngressRule{rule},
+		},
+	}
+
+	if spec.Tls != nil {
+		secretName := spec.Tls.SecretName
+		if secretName == "" {
+			secretName = cr.Spec.General.ClusterName + "-dashboards-ingress-tls"
+		}
+		hosts := []string{}
+		if spec.Host != "" {
+			hosts = []string{spec.Host}
+		}
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{Hosts: hosts, SecretName: secretName}}
+	}
+
+	return ingress
+}
+
 func dashboardsLabels(cr *opsterv1.OpenSearchCluster) map[string]string {
 	return map[string]string{
 		"opster.io/cluster":   cr.Spec.General.ClusterName,
@@ -1,7 +1,13 @@
 package builders
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	opensearch "github.com/opensearch-project/opensearch-go"
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
 	opsterv1 "opensearch.opster.io/api/v1"
 )
 
@@ -20,3 +26,56 @@ func NewOsClusterClient(cr *opsterv1.OpenSearchCluster) (*OsClusterClient, error
 	}
 	return &OsClusterClient{client: client}, nil
 }
+
+// ClusterHealth is the subset of the OpenSearch _cluster/health response the scheduler cares about.
+type ClusterHealth struct {
+	Status string `json:"status"`
+}
+
+// GetClusterHealth calls GET _cluster/health.
+func (c *OsClusterClient) GetClusterHealth(ctx context.Context) (*ClusterHealth, error) {
+	res, err := c.client.Cluster.Health(c.client.Cluster.Health.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return nil, fmt.Errorf("cluster health request failed: %s", res.String())
+	}
+	health := ClusterHealth{}
+	if err := json.NewDecoder(res.Body).Decode(&health); err != nil {
+		return nil, err
+	}
+	return &health, nil
+}
+
+// PutIndexTemplate calls PUT _index_template/<name> with body as the raw template document.
+func (c *OsClusterClient) PutIndexTemplate(ctx context.Context, name string, body string) error {
+	req := opensearchapi.IndicesPutIndexTemplateRequest{Name: name, Body: strings.NewReader(body)}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("put index template %q failed: %s", name, res.String())
+	}
+	return nil
+}
+
+// PutISMPolicy calls PUT _plugins/_ism/policies/<policyID> with body as the raw policy document.
+func (c *OsClusterClient) PutISMPolicy(ctx context.Context, policyID string, body string) error {
+	req, err := opensearchapi.NewRequest("PUT", "/_plugins/_ism/policies/"+policyID, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return fmt.Errorf("put ISM policy %q failed: %s", policyID, res.String())
+	}
+	return nil
+}
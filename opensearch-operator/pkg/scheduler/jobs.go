@@ -0,0 +1,112 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	opsterv1 "opensearch.opster.io/api/v1"
+	"opensearch.opster.io/pkg/builders"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ClusterHealthSync polls the cluster health API of every OpenSearchCluster and writes the result
+// into Status.ClusterHealth, independent of whatever the CR-driven reconcile loop is doing.
+type ClusterHealthSync struct {
+	Client client.Client
+	Logger logr.Logger
+}
+
+func (j *ClusterHealthSync) Name() string           { return "cluster-health-sync" }
+func (j *ClusterHealthSync) Interval() time.Duration { return 30 * time.Second }
+
+func (j *ClusterHealthSync) Run(ctx context.Context) error {
+	clusters := opsterv1.OpenSearchClusterList{}
+	if err := j.Client.List(ctx, &clusters); err != nil {
+		return err
+	}
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		osClient, err := builders.NewOsClusterClient(cluster)
+		if err != nil {
+			j.Logger.Error(err, "Failed to build OpenSearch client", "cluster", cluster.Name)
+			continue
+		}
+		health, err := osClient.GetClusterHealth(ctx)
+		if err != nil {
+			j.Logger.Error(err, "Failed to fetch cluster health", "cluster", cluster.Name)
+			continue
+		}
+		cluster.Status.ClusterHealth = health.Status
+		if err := j.Client.Status().Update(ctx, cluster); err != nil {
+			j.Logger.Error(err, "Failed to persist cluster health", "cluster", cluster.Name)
+		}
+	}
+	return nil
+}
+
+// IndexTemplateSync applies every declared OpenSearchIndexTemplate to its target cluster.
+type IndexTemplateSync struct {
+	Client client.Client
+	Logger logr.Logger
+}
+
+func (j *IndexTemplateSync) Name() string           { return "index-template-sync" }
+func (j *IndexTemplateSync) Interval() time.Duration { return 5 * time.Minute }
+
+func (j *IndexTemplateSync) Run(ctx context.Context) error {
+	templates := opsterv1.OpenSearchIndexTemplateList{}
+	if err := j.Client.List(ctx, &templates); err != nil {
+		return err
+	}
+	for i := range templates.Items {
+		template := &templates.Items[i]
+		cluster := opsterv1.OpenSearchCluster{}
+		if err := j.Client.Get(ctx, client.ObjectKey{Name: template.Spec.OpensearchRef.Name, Namespace: template.Namespace}, &cluster); err != nil {
+			j.Logger.Error(err, "Failed to resolve cluster for index template", "template", template.Name)
+			continue
+		}
+		osClient, err := builders.NewOsClusterClient(&cluster)
+		if err != nil {
+			j.Logger.Error(err, "Failed to build OpenSearch client", "cluster", cluster.Name)
+			continue
+		}
+		if err := osClient.PutIndexTemplate(ctx, template.Spec.Name, template.Spec.Body); err != nil {
+			j.Logger.Error(err, "Failed to apply index template", "template", template.Name)
+		}
+	}
+	return nil
+}
+
+// ISMPolicySync applies every declared OpenSearchISMPolicy to its target cluster.
+type ISMPolicySync struct {
+	Client client.Client
+	Logger logr.Logger
+}
+
+func (j *ISMPolicySync) Name() string           { return "ism-policy-sync" }
+func (j *ISMPolicySync) Interval() time.Duration { return 5 * time.Minute }
+
+func (j *ISMPolicySync) Run(ctx context.Context) error {
+	policies := opsterv1.OpenSearchISMPolicyList{}
+	if err := j.Client.List(ctx, &policies); err != nil {
+		return err
+	}
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		cluster := opsterv1.OpenSearchCluster{}
+		if err := j.Client.Get(ctx, client.ObjectKey{Name: policy.Spec.OpensearchRef.Name, Namespace: policy.Namespace}, &cluster); err != nil {
+			j.Logger.Error(err, "Failed to resolve cluster for ISM policy", "policy", policy.Name)
+			continue
+		}
+		osClient, err := builders.NewOsClusterClient(&cluster)
+		if err != nil {
+			j.Logger.Error(err, "Failed to build OpenSearch client", "cluster", cluster.Name)
+			continue
+		}
+		if err := osClient.PutISMPolicy(ctx, policy.Spec.PolicyID, policy.Spec.Body); err != nil {
+			j.Logger.Error(err, "Failed to apply ISM policy", "policy", policy.Name)
+		}
+	}
+	return nil
+}
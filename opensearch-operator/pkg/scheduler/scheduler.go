@@ -0,0 +1,76 @@
+// Package scheduler runs periodic background jobs against the OpenSearch REST API, decoupled from
+// the CR-driven controller-runtime reconcile loop. Jobs are registered once at manager start and
+// re-schedule themselves with jitter so a fleet of operator replicas doesn't hammer the cluster in
+// lockstep.
+package scheduler
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Job is a single periodic task. Run is called once per Interval while this operator replica holds
+// the leader-election lock.
+type Job interface {
+	Name() string
+	Interval() time.Duration
+	Run(ctx context.Context) error
+}
+
+// Scheduler owns a set of registered Jobs and runs each on its own ticker goroutine. It implements
+// manager.LeaderElectionRunnable, so controller-runtime only calls Start once this operator replica
+// has itself won the manager's leader-election lock - there is no separate election to wire up.
+type Scheduler struct {
+	Logger logr.Logger
+	jobs   []Job
+}
+
+// New creates a Scheduler. Register jobs on it and add it to the manager with mgr.Add so it starts
+// and stops with the rest of the operator.
+func New(logger logr.Logger) *Scheduler {
+	return &Scheduler{Logger: logger}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable: Start is only ever invoked on the
+// replica that holds the manager's leader-election lock.
+func (s *Scheduler) NeedLeaderElection() bool {
+	return true
+}
+
+// Register adds a Job to be started by Start. Call before Start; Register is not safe to call
+// concurrently with a running scheduler.
+func (s *Scheduler) Register(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start launches a goroutine per registered Job and blocks until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	for _, job := range s.jobs {
+		go s.runJob(ctx, job)
+	}
+	<-ctx.Done()
+	return nil
+}
+
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(withJitter(job.Interval())):
+		}
+		if err := job.Run(ctx); err != nil {
+			s.Logger.Error(err, "Scheduled job failed", "job", job.Name())
+		}
+	}
+}
+
+// withJitter spreads job runs by +/-10% so replicas that start at the same instant don't converge
+// on the same tick forever.
+func withJitter(interval time.Duration) time.Duration {
+	jitter := time.Duration(rand.Int63n(int64(interval) / 5)) // 0-20% of interval
+	return interval - interval/10 + jitter
+}